@@ -3,6 +3,10 @@ package v1
 type TaskSubmitRequest struct {
 	Language string `json:"language,required" vd:"len($)>0"`
 	Code     string `json:"code,required" vd:"len($)>0"`
+	Stdin    string `json:"stdin"` // 程序的标准输入，可选
+	
+	TimeLimit    int64 `json:"time_limit" vd:"$>=0"`     // 墙钟时间限制（毫秒），不传则使用默认值
+	CPUTimeLimit int64 `json:"cpu_time_limit" vd:"$>=0"` // CPU 时间限制（毫秒），不传则使用默认值
 }
 
 type TaskSubmitResponseBody struct {
@@ -20,6 +24,14 @@ type TaskResultResponseBody struct {
 	Status   string `json:"status"`
 	Stdout   string `json:"stdout"`
 	Stderr   string `json:"stderr"`
+	ExitCode int    `json:"exit_code"`
+	Time     int64  `json:"time"`     // 墙钟时间（毫秒）
+	CPUTime  int64  `json:"cpu_time"` // CPU 时间（毫秒）
+	Memory   int64  `json:"memory"`   // 峰值内存（字节）
+	
+	CompileStdout string `json:"compile_stdout,omitempty"` // 编译器标准输出，仅需要编译的语言返回
+	CompileStderr string `json:"compile_stderr,omitempty"` // 编译器标准错误
+	CompileTime   int64  `json:"compile_time"`             // 编译耗时（毫秒）
 }
 
 type TaskResultResponse struct {
@@ -85,6 +85,7 @@ func main() {
 	g.ApplyBasic(
 		// g.GenerateAllTable(fieldOpts...),
 		g.GenerateModel("submit_infos"),
+		g.GenerateModel("task_cases"),
 		g.GenerateModel("task_infos"),
 	)
 	
@@ -0,0 +1,177 @@
+//go:build linux
+
+// sandbox-monitor 运行在沙箱容器内，负责启动用户程序、限制 CPU 时间与内存并统计资源使用情况。
+// 构建方式：CGO_ENABLED=0 GOOS=linux go build -o bin/sandbox-monitor ./cmd/monitor
+package main
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
+	
+	"github.com/Wenrh2004/sandbox/pkg/monitor"
+)
+
+// 无法启动用户程序时的退出码，与 shell 保持一致
+const exitCodeNotFound = 127
+
+// 检查进程组内存占用的间隔
+const memoryPollInterval = 10 * time.Millisecond
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: sandbox-monitor command [args...]")
+		os.Exit(2)
+	}
+	
+	// CPU 时间限制通过 RLIMIT_CPU 实现，子进程会继承该限制
+	if v := os.Getenv(monitor.EnvCPULimit); v != "" {
+		ms, err := strconv.ParseInt(v, 10, 64)
+		if err == nil && ms > 0 {
+			seconds := uint64(math.Ceil(float64(ms) / 1000))
+			if err := syscall.Setrlimit(syscall.RLIMIT_CPU, &syscall.Rlimit{Cur: seconds, Max: seconds + 1}); err != nil {
+				fmt.Fprintf(os.Stderr, "sandbox-monitor: set cpu limit: %v\n", err)
+				os.Exit(2)
+			}
+		}
+		_ = os.Unsetenv(monitor.EnvCPULimit)
+	}
+	
+	// 内存限制作用于整个进程组，容器的 cgroup 限制是所有阶段共享的上限
+	var memoryLimit int64
+	if v := os.Getenv(monitor.EnvMemoryLimit); v != "" {
+		memoryLimit, _ = strconv.ParseInt(v, 10, 64)
+		_ = os.Unsetenv(monitor.EnvMemoryLimit)
+	}
+	
+	cmd := exec.Command(os.Args[1], os.Args[2:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	// 用户程序单独成组，便于结束后清理其派生的进程
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	
+	oomKills := readOOMKillCount()
+	start := time.Now()
+	if err := cmd.Start(); err != nil {
+		fmt.Fprintf(os.Stderr, "sandbox-monitor: %v\n", err)
+		writeReport(&monitor.Report{ExitCode: exitCodeNotFound})
+		os.Exit(exitCodeNotFound)
+	}
+	var memoryExceeded atomic.Bool
+	stop := make(chan struct{})
+	if memoryLimit > 0 {
+		go watchMemory(cmd.Process.Pid, memoryLimit, &memoryExceeded, stop)
+	}
+	_ = cmd.Wait()
+	elapsed := time.Since(start)
+	close(stop)
+	_ = syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	
+	report := &monitor.Report{
+		Time:           elapsed.Nanoseconds(),
+		OOMKilled:      readOOMKillCount() > oomKills,
+		MemoryExceeded: memoryExceeded.Load(),
+	}
+	if usage, ok := cmd.ProcessState.SysUsage().(*syscall.Rusage); ok {
+		report.CPUTime = usage.Utime.Nano() + usage.Stime.Nano()
+		report.Memory = usage.Maxrss * 1024 // Linux 下 ru_maxrss 单位为 KB
+	}
+	if memoryLimit > 0 && report.Memory > memoryLimit {
+		report.MemoryExceeded = true
+	}
+	if status, ok := cmd.ProcessState.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		report.Signal = int(status.Signal())
+		report.ExitCode = 128 + report.Signal
+	} else {
+		report.ExitCode = cmd.ProcessState.ExitCode()
+	}
+	
+	writeReport(report)
+	os.Exit(report.ExitCode)
+}
+
+// watchMemory 定期统计进程组的常驻内存，超过限制时终止整个进程组
+func watchMemory(pgid int, limit int64, exceeded *atomic.Bool, stop <-chan struct{}) {
+	ticker := time.NewTicker(memoryPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if groupRSS(pgid) > limit {
+				exceeded.Store(true)
+				_ = syscall.Kill(-pgid, syscall.SIGKILL)
+				return
+			}
+		}
+	}
+}
+
+// groupRSS 返回进程组内所有进程的常驻内存之和（字节）
+func groupRSS(pgid int) int64 {
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return 0
+	}
+	pageSize := int64(os.Getpagesize())
+	var total int64
+	for _, entry := range entries {
+		if _, err := strconv.Atoi(entry.Name()); err != nil {
+			continue
+		}
+		data, err := os.ReadFile("/proc/" + entry.Name() + "/stat")
+		if err != nil {
+			continue
+		}
+		// 进程名可能包含空格，从最后一个右括号之后开始解析，第 0 个字段为 state
+		stat := string(data)
+		fields := strings.Fields(stat[strings.LastIndexByte(stat, ')')+1:])
+		if len(fields) < 22 || fields[2] != strconv.Itoa(pgid) {
+			continue
+		}
+		rss, _ := strconv.ParseInt(fields[21], 10, 64)
+		total += rss * pageSize
+	}
+	return total
+}
+
+// 容器 cgroup 中记录 OOM Kill 次数的文件，依次尝试 cgroup v2 与 v1
+var oomEventFiles = []string{
+	"/sys/fs/cgroup/memory.events",
+	"/sys/fs/cgroup/memory/memory.oom_control",
+}
+
+// readOOMKillCount 读取容器内发生 OOM Kill 的累计次数，无法读取时返回 0
+func readOOMKillCount() int64 {
+	for _, file := range oomEventFiles {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			fields := strings.Fields(line)
+			if len(fields) == 2 && fields[0] == "oom_kill" {
+				count, _ := strconv.ParseInt(fields[1], 10, 64)
+				return count
+			}
+		}
+	}
+	return 0
+}
+
+func writeReport(report *monitor.Report) {
+	line, err := report.Encode()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "sandbox-monitor: encode report: %v\n", err)
+		return
+	}
+	_, _ = os.Stderr.WriteString(line)
+}
@@ -2,6 +2,7 @@ package convert
 
 import (
 	"errors"
+	"time"
 	
 	v1 "github.com/Wenrh2004/sandbox/api/v1"
 	"github.com/Wenrh2004/sandbox/internal/task/domain/aggregate"
@@ -16,38 +17,40 @@ func TaskSubmitRequestConvert(request *v1.TaskSubmitRequest, appID uint64, submi
 		return nil, ErrUnsupportedLanguage
 	}
 	return &aggregate.Task{
-		ID:       "",
-		SubmitID: submitID,
-		AppID:    appID,
-		Language: l,
-		Code:     request.Code,
+		ID:           "",
+		SubmitID:     submitID,
+		AppID:        appID,
+		Language:     l,
+		Code:         request.Code,
+		Stdin:        request.Stdin,
+		TimeLimit:    time.Duration(request.TimeLimit) * time.Millisecond,
+		CPUTimeLimit: time.Duration(request.CPUTimeLimit) * time.Millisecond,
 	}, nil
 }
 
 func TaskResultResponseConvert(request *aggregate.Task) *v1.TaskResultResponseBody {
-	if request.Stdout == nil {
-		return &v1.TaskResultResponseBody{
-			TaskID:   request.ID,
-			Language: request.Language.String(),
-			Status:   request.Status.GetMsg(),
-			Stdout:   "",
-			Stderr:   *request.Stderr,
-		}
-	}
-	if request.Stderr == nil {
-		return &v1.TaskResultResponseBody{
-			TaskID:   request.ID,
-			Language: request.Language.String(),
-			Status:   request.Status.GetMsg(),
-			Stdout:   *request.Stdout,
-			Stderr:   "",
-		}
-	}
-	return &v1.TaskResultResponseBody{
+	resp := &v1.TaskResultResponseBody{
 		TaskID:   request.ID,
 		Language: request.Language.String(),
 		Status:   request.Status.GetMsg(),
-		Stdout:   *request.Stdout,
-		Stderr:   *request.Stderr,
+		ExitCode: request.ExitCode,
+		Time:     request.Time.Milliseconds(),
+		CPUTime:  request.CPUTime.Milliseconds(),
+		Memory:   request.Memory,
+		
+		CompileTime: request.CompileTime.Milliseconds(),
+	}
+	if request.Stdout != nil {
+		resp.Stdout = *request.Stdout
+	}
+	if request.Stderr != nil {
+		resp.Stderr = *request.Stderr
+	}
+	if request.CompileStdout != nil {
+		resp.CompileStdout = *request.CompileStdout
+	}
+	if request.CompileStderr != nil {
+		resp.CompileStderr = *request.CompileStderr
 	}
+	return resp
 }
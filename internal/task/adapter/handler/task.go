@@ -92,6 +92,16 @@ func (t *TaskHandler) Submit(ctx context.Context, c *app.RequestContext) {
 			v1.HandlerError(c, v1.ErrBadRequest)
 			return
 		}
+		if errors.Is(err, service.ErrInvalidLimit) {
+			t.Logger.WithContext(ctx).Error("[TaskHandler.Submit]invalid time limit", zap.Error(err))
+			v1.HandlerError(c, v1.ErrBadRequest)
+			return
+		}
+		if errors.Is(err, service.ErrStdinLimit) {
+			t.Logger.WithContext(ctx).Error("[TaskHandler.Submit]stdin too large", zap.Error(err))
+			v1.HandlerError(c, v1.ErrBadRequest)
+			return
+		}
 		if errors.Is(err, service.ErrTaskLimit) {
 			t.Logger.WithContext(ctx).Error("[TaskHandler.Submit]task limit exceeded", zap.Error(err))
 			v1.HandlerError(c, v1.ErrLimitExceeded)
@@ -7,16 +7,28 @@ import (
 )
 
 type Task struct {
-	ID       string        `json:"id"`
-	SubmitID string        `json:"submit_id"`
-	AppID    uint64        `json:"app_id"`
-	Language *vo.Language  `json:"language"`
-	Code     string        `json:"code"`
-	Status   vo.Status     `json:"status"`
-	Stdout   *string       `json:"stdout"`
-	Stderr   *string       `json:"stderr"`
-	Memory   int64         `json:"memory"`
-	Time     time.Duration `json:"time"`
+	ID       string            `json:"id"`
+	SubmitID string            `json:"submit_id"`
+	AppID    uint64            `json:"app_id"`
+	Language *vo.Language      `json:"language"`
+	Code     string            `json:"code"`
+	Stdin    string            `json:"stdin"`
+	Status   vo.Status         `json:"status"`
+	Stdout   *string           `json:"stdout"`
+	Stderr   *string           `json:"stderr"`
+	ExitCode int               `json:"exit_code"`
+	Memory   int64             `json:"memory"`
+	Time     time.Duration     `json:"time"`
+	CPUTime  time.Duration     `json:"cpu_time"`
+	Limits   *vo.ResourceLimit `json:"limits"`
+	
+	CompileStdout *string       `json:"compile_stdout"` // 编译器标准输出，无需编译的语言为 nil
+	CompileStderr *string       `json:"compile_stderr"` // 编译器标准错误
+	CompileTime   time.Duration `json:"compile_time"`   // 编译耗时（墙钟时间）
+	
+	TimeLimit    time.Duration `json:"time_limit"`     // 墙钟时间限制
+	CPUTimeLimit time.Duration `json:"cpu_time_limit"` // CPU 时间限制
+	OutputLimit  int64         `json:"output_limit"`   // 输出字节数限制
 }
 
 func (t *Task) GetFileName() string {
@@ -0,0 +1,12 @@
+package vo
+
+// ResourceLimit 任务执行时生效的资源限制
+type ResourceLimit struct {
+	Memory     int64            `json:"memory"`      // 内存上限（字节）
+	MemorySwap int64            `json:"memory_swap"` // 内存 + swap 上限（字节）
+	CPUPeriod  int64            `json:"cpu_period"`  // CFS 调度周期（微秒）
+	CPUQuota   int64            `json:"cpu_quota"`   // 每个调度周期内可用的 CPU 时间（微秒）
+	CPUShares  int64            `json:"cpu_shares"`  // CPU 相对权重
+	PidsLimit  int64            `json:"pids_limit"`  // 进程数上限
+	Ulimits    map[string]int64 `json:"ulimits"`     // ulimit
+}
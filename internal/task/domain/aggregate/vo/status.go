@@ -4,6 +4,12 @@ var (
 	Pending = newStatus(0, "Pending")
 	Success = newStatus(1, "Success")
 	Failed  = newStatus(2, "Failed")
+	
+	TimeLimitExceeded   = newStatus(3, "TimeLimitExceeded")
+	CompileError        = newStatus(4, "CompileError")
+	RuntimeError        = newStatus(5, "RuntimeError")
+	MemoryLimitExceeded = newStatus(6, "MemoryLimitExceeded")
+	OutputLimitExceeded = newStatus(7, "OutputLimitExceeded")
 )
 
 type Status struct {
@@ -26,6 +32,16 @@ func GetStatusByString(s string) *Status {
 		return Success
 	case Failed.statusMsg:
 		return Failed
+	case TimeLimitExceeded.statusMsg:
+		return TimeLimitExceeded
+	case CompileError.statusMsg:
+		return CompileError
+	case RuntimeError.statusMsg:
+		return RuntimeError
+	case MemoryLimitExceeded.statusMsg:
+		return MemoryLimitExceeded
+	case OutputLimitExceeded.statusMsg:
+		return OutputLimitExceeded
 	default:
 		return nil
 	}
@@ -39,6 +55,16 @@ func GetStatusByCode(code byte) *Status {
 		return Success
 	case Failed.statusCode:
 		return Failed
+	case TimeLimitExceeded.statusCode:
+		return TimeLimitExceeded
+	case CompileError.statusCode:
+		return CompileError
+	case RuntimeError.statusCode:
+		return RuntimeError
+	case MemoryLimitExceeded.statusCode:
+		return MemoryLimitExceeded
+	case OutputLimitExceeded.statusCode:
+		return OutputLimitExceeded
 	default:
 		return nil
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 	
 	"github.com/google/uuid"
 	"github.com/panjf2000/ants/v2"
@@ -22,6 +23,16 @@ var (
 	ErrUnsupported  = errors.New("[TaskDomainService.Submit]unsupported language")
 	ErrTaskLimit    = errors.New("[TaskDomainService.Submit]user task limit reached")
 	ErrTaskNotFound = errors.New("[TaskDomainService.GetResult]task not found")
+	ErrInvalidLimit = errors.New("[TaskDomainService.Submit]time limit exceeds the maximum allowed")
+	ErrStdinLimit   = errors.New("[TaskDomainService.Submit]stdin exceeds the maximum allowed size")
+)
+
+const (
+	defaultTimeLimit        = 5 * time.Second
+	defaultMaxTimeLimit     = 30 * time.Second
+	defaultCompileTimeLimit = 10 * time.Second
+	defaultOutputLimit      = 1 << 20
+	defaultStdinLimit       = 1 << 20
 )
 
 // TaskDomainService 结构体
@@ -34,6 +45,15 @@ type TaskDomainService struct {
 	submitStore    repository.SubmitInfoRepository
 	maxTaskPerUser int
 	mu             sync.Mutex
+	
+	timeLimit       time.Duration      // 默认墙钟时间限制
+	cpuTimeLimit    time.Duration      // 默认 CPU 时间限制
+	maxTimeLimit    time.Duration      // 允许提交的最大墙钟时间限制
+	maxCPUTimeLimit time.Duration      // 允许提交的最大 CPU 时间限制
+	outputLimit     int64              // 输出字节数限制
+	stdinLimit      int64              // 标准输入字节数限制
+	memoryLimit     int64              // 运行阶段的内存限制，为 0 时只受容器限制
+	compileLimits   runner.PhaseLimits // 编译阶段的限制
 }
 
 // NewTaskService 初始化任务服务
@@ -50,14 +70,42 @@ func NewTaskService(
 	}
 	userTaskCounts := make(map[uint64]int)
 	return &TaskDomainService{
-		Service:        srv,
-		pool:           p,
-		runner:         r,
-		maxTaskPerUser: conf.GetInt("app.task.user_max_task"),
-		userTaskCounts: userTaskCounts,
-		resultStore:    taskRepository,
-		submitStore:    submitRepository,
+		Service:         srv,
+		pool:            p,
+		runner:          r,
+		maxTaskPerUser:  conf.GetInt("app.task.user_max_task"),
+		userTaskCounts:  userTaskCounts,
+		resultStore:     taskRepository,
+		submitStore:     submitRepository,
+		timeLimit:       getDuration(conf, "app.task.time_limit", defaultTimeLimit),
+		cpuTimeLimit:    getDuration(conf, "app.task.cpu_time_limit", defaultTimeLimit),
+		maxTimeLimit:    getDuration(conf, "app.task.max_time_limit", defaultMaxTimeLimit),
+		maxCPUTimeLimit: getDuration(conf, "app.task.max_cpu_time_limit", defaultMaxTimeLimit),
+		outputLimit:     getInt64(conf, "app.task.output_limit", defaultOutputLimit),
+		stdinLimit:      getInt64(conf, "app.task.stdin_limit", defaultStdinLimit),
+		memoryLimit:     int64(conf.GetSizeInBytes("app.task.memory_limit")),
+		compileLimits: runner.PhaseLimits{
+			TimeLimit:    getDuration(conf, "app.task.compile.time_limit", defaultCompileTimeLimit),
+			CPUTimeLimit: getDuration(conf, "app.task.compile.cpu_time_limit", defaultCompileTimeLimit),
+			MemoryLimit:  int64(conf.GetSizeInBytes("app.task.compile.memory_limit")),
+		},
+	}
+}
+
+// getDuration 读取时长配置，未配置时返回默认值
+func getDuration(conf *viper.Viper, key string, def time.Duration) time.Duration {
+	if d := conf.GetDuration(key); d > 0 {
+		return d
 	}
+	return def
+}
+
+// getInt64 读取整数配置，未配置时返回默认值
+func getInt64(conf *viper.Viper, key string, def int64) int64 {
+	if v := conf.GetInt64(key); v > 0 {
+		return v
+	}
+	return def
 }
 
 // Submit 提交任务：代码 + 文件名 + 用户ID
@@ -68,6 +116,22 @@ func (s *TaskDomainService) Submit(ctx context.Context, task *aggregate.Task) (s
 	if lang == "" {
 		return "", ErrUnsupported
 	}
+	task.Limits = resourceLimitConvert(s.runner.GetResourceLimits(lang))
+	
+	// 时间限制检测，未指定时使用默认值
+	if task.TimeLimit == 0 {
+		task.TimeLimit = s.timeLimit
+	}
+	if task.CPUTimeLimit == 0 {
+		task.CPUTimeLimit = s.cpuTimeLimit
+	}
+	if task.TimeLimit > s.maxTimeLimit || task.CPUTimeLimit > s.maxCPUTimeLimit {
+		return "", ErrInvalidLimit
+	}
+	task.OutputLimit = s.outputLimit
+	if int64(len(task.Stdin)) > s.stdinLimit {
+		return "", ErrStdinLimit
+	}
 	
 	// 限流检测
 	if !s.acquireUserSlot(task.AppID) {
@@ -93,21 +157,40 @@ func (s *TaskDomainService) Submit(ctx context.Context, task *aggregate.Task) (s
 	// 封装执行逻辑
 	err := s.pool.Submit(func() {
 		defer s.releaseUserSlot(task.AppID)
-		output, err := s.runner.Exec(ctx, lang, filename, task.Code)
+		result, err := s.runner.Exec(ctx, &runner.ExecRequest{
+			Language:      lang,
+			FileName:      filename,
+			FileContent:   task.Code,
+			Stdin:         task.Stdin,
+			CompileLimits: s.compileLimits,
+			RunLimits: runner.PhaseLimits{
+				TimeLimit:    task.TimeLimit,
+				CPUTimeLimit: task.CPUTimeLimit,
+				MemoryLimit:  s.memoryLimit,
+			},
+			OutputLimit: task.OutputLimit,
+		})
 		if err != nil {
 			stdErr := err.Error()
 			task.Stderr = &stdErr
 			task.Status = *vo.Failed
-			if err := s.resultStore.UpdateTaskInfo(ctx, task); err != nil {
-				s.Logger.Error("[TaskDomainService.Submit] failed to update task info", zap.Error(err))
-			}
 		} else {
-			task.Stdout = &output
-			task.Status = *vo.Success
-			if err := s.resultStore.UpdateTaskInfo(ctx, task); err != nil {
-				s.Logger.Error("[TaskDomainService.Submit] failed to update task info", zap.Error(err))
+			task.Stdout = &result.Stdout
+			task.Stderr = &result.Stderr
+			task.ExitCode = result.ExitCode
+			task.Time = result.Time
+			task.CPUTime = result.CPUTime
+			task.Memory = result.Memory
+			task.Status = *verdictConvert(result.Verdict)
+			if result.Compile != nil {
+				task.CompileStdout = &result.Compile.Stdout
+				task.CompileStderr = &result.Compile.Stderr
+				task.CompileTime = result.Compile.Time
 			}
 		}
+		if err := s.resultStore.UpdateTaskInfo(ctx, task); err != nil {
+			s.Logger.Error("[TaskDomainService.Submit] failed to update task info", zap.Error(err))
+		}
 	})
 	if err != nil {
 		s.releaseUserSlot(task.AppID)
@@ -126,6 +209,38 @@ func (s *TaskDomainService) GetResult(ctx context.Context, taskID string) (*aggr
 	return result, nil
 }
 
+// verdictConvert 将执行结论转换为任务状态
+func verdictConvert(verdict runner.Verdict) *vo.Status {
+	switch verdict {
+	case runner.VerdictOK:
+		return vo.Success
+	case runner.VerdictCompileError:
+		return vo.CompileError
+	case runner.VerdictRuntimeError:
+		return vo.RuntimeError
+	case runner.VerdictTimeLimitExceeded:
+		return vo.TimeLimitExceeded
+	case runner.VerdictMemoryLimitExceeded:
+		return vo.MemoryLimitExceeded
+	case runner.VerdictOutputLimitExceeded:
+		return vo.OutputLimitExceeded
+	default:
+		return vo.Failed
+	}
+}
+
+func resourceLimitConvert(limits runner.ResourceLimits) *vo.ResourceLimit {
+	return &vo.ResourceLimit{
+		Memory:     limits.Memory,
+		MemorySwap: limits.MemorySwap,
+		CPUPeriod:  limits.CPUPeriod,
+		CPUQuota:   limits.CPUQuota,
+		CPUShares:  limits.CPUShares,
+		PidsLimit:  limits.PidsLimit,
+		Ulimits:    limits.Ulimits,
+	}
+}
+
 // ----------- 用户限流部分 -----------
 
 func (s *TaskDomainService) acquireUserSlot(userID uint64) bool {
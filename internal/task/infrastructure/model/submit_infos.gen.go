@@ -18,6 +18,7 @@ type SubmitInfo struct {
 	AppID     uint64    `gorm:"column:app_id;type:bigint;not null;comment:创建人" json:"app_id"`                                       // 创建人
 	Language  string    `gorm:"column:language;type:varchar(10);not null;comment:提交语言" json:"language"`                             // 提交语言
 	Code      *string   `gorm:"column:code;type:text;comment:代码" json:"code"`                                                       // 代码
+	Stdin     *string   `gorm:"column:stdin;type:text;comment:标准输入" json:"stdin"`                                                   // 标准输入
 	CreatedAt time.Time `gorm:"column:created_at;type:timestamp;not null;default:CURRENT_TIMESTAMP;comment:提交时间" json:"created_at"` // 提交时间
 }
 
@@ -12,15 +12,21 @@ const TableNameTaskInfo = "task_infos"
 
 // TaskInfo 任务信息
 type TaskInfo struct {
-	ID        string    `gorm:"column:id;type:varchar(50);primaryKey;comment:任务ID" json:"id"` // 任务ID
-	Language  string    `gorm:"column:language;type:varchar(10);not null" json:"language"`
-	Status    byte      `gorm:"column:status;type:tinyint;not null;comment:任务状态 0 - 待执行 1 - 执行中 2 - 执行成功 3 - 执行失败" json:"status"`   // 任务状态 0 - 待执行 1 - 执行中 2 - 执行成功 3 - 执行失败
-	Output    *string   `gorm:"column:output;type:text;comment:执行结果" json:"output"`                                                 // 执行结果
-	ErrOutput *string   `gorm:"column:err_output;type:text;comment:错误输出" json:"err_output"`                                         // 错误输出
-	Memory    *int64    `gorm:"column:memory;type:bigint;comment:内存使用" json:"memory"`                                               // 内存使用
-	Time      *int64    `gorm:"column:time;type:bigint;comment:执行时间" json:"time"`                                                   // 执行时间
-	CreatedAt time.Time `gorm:"column:created_at;type:timestamp;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"` // 创建时间
-	UpdatedAt time.Time `gorm:"column:updated_at;type:timestamp;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"` // 更新时间
+	ID               string    `gorm:"column:id;type:varchar(50);primaryKey;comment:任务ID" json:"id"` // 任务ID
+	Language         string    `gorm:"column:language;type:varchar(10);not null" json:"language"`
+	Status           byte      `gorm:"column:status;type:tinyint;not null;comment:任务状态 0 - 待执行 1 - 执行中 2 - 执行成功 3 - 执行失败" json:"status"`   // 任务状态 0 - 待执行 1 - 执行中 2 - 执行成功 3 - 执行失败
+	Output           *string   `gorm:"column:output;type:text;comment:执行结果" json:"output"`                                                 // 执行结果
+	ErrOutput        *string   `gorm:"column:err_output;type:text;comment:错误输出" json:"err_output"`                                         // 错误输出
+	Memory           *int64    `gorm:"column:memory;type:bigint;comment:内存使用" json:"memory"`                                               // 内存使用
+	Time             *int64    `gorm:"column:time;type:bigint;comment:执行时间" json:"time"`                                                   // 执行时间
+	CPUTime          *int64    `gorm:"column:cpu_time;type:bigint;comment:CPU时间" json:"cpu_time"`                                          // CPU时间
+	ExitCode         *int32    `gorm:"column:exit_code;type:int;comment:退出码" json:"exit_code"`                                             // 退出码
+	CompileOutput    *string   `gorm:"column:compile_output;type:text;comment:编译输出" json:"compile_output"`                                 // 编译输出
+	CompileErrOutput *string   `gorm:"column:compile_err_output;type:text;comment:编译错误输出" json:"compile_err_output"`                       // 编译错误输出
+	CompileTime      *int64    `gorm:"column:compile_time;type:bigint;comment:编译时间" json:"compile_time"`                                   // 编译时间
+	Score            *int32    `gorm:"column:score;type:int;comment:得分" json:"score"`                                                      // 得分
+	CreatedAt        time.Time `gorm:"column:created_at;type:timestamp;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"` // 创建时间
+	UpdatedAt        time.Time `gorm:"column:updated_at;type:timestamp;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"` // 更新时间
 }
 
 // TableName TaskInfo's table name
@@ -18,12 +18,14 @@ import (
 var (
 	Q          = new(Query)
 	SubmitInfo *submitInfo
+	TaskCase   *taskCase
 	TaskInfo   *taskInfo
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
 	SubmitInfo = &Q.SubmitInfo
+	TaskCase = &Q.TaskCase
 	TaskInfo = &Q.TaskInfo
 }
 
@@ -31,6 +33,7 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
 		db:         db,
 		SubmitInfo: newSubmitInfo(db, opts...),
+		TaskCase:   newTaskCase(db, opts...),
 		TaskInfo:   newTaskInfo(db, opts...),
 	}
 }
@@ -39,6 +42,7 @@ type Query struct {
 	db *gorm.DB
 
 	SubmitInfo submitInfo
+	TaskCase   taskCase
 	TaskInfo   taskInfo
 }
 
@@ -48,6 +52,7 @@ func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
 		db:         db,
 		SubmitInfo: q.SubmitInfo.clone(db),
+		TaskCase:   q.TaskCase.clone(db),
 		TaskInfo:   q.TaskInfo.clone(db),
 	}
 }
@@ -64,18 +69,21 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
 		db:         db,
 		SubmitInfo: q.SubmitInfo.replaceDB(db),
+		TaskCase:   q.TaskCase.replaceDB(db),
 		TaskInfo:   q.TaskInfo.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
 	SubmitInfo ISubmitInfoDo
+	TaskCase   ITaskCaseDo
 	TaskInfo   ITaskInfoDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
 		SubmitInfo: q.SubmitInfo.WithContext(ctx),
+		TaskCase:   q.TaskCase.WithContext(ctx),
 		TaskInfo:   q.TaskInfo.WithContext(ctx),
 	}
 }
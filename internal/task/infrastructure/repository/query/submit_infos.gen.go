@@ -34,6 +34,7 @@ func newSubmitInfo(db *gorm.DB, opts ...gen.DOOption) submitInfo {
 	_submitInfo.AppID = field.NewUint64(tableName, "app_id")
 	_submitInfo.Language = field.NewString(tableName, "language")
 	_submitInfo.Code = field.NewString(tableName, "code")
+	_submitInfo.Stdin = field.NewString(tableName, "stdin")
 	_submitInfo.CreatedAt = field.NewTime(tableName, "created_at")
 
 	_submitInfo.fillFieldMap()
@@ -52,6 +53,7 @@ type submitInfo struct {
 	AppID     field.Uint64 // 创建人
 	Language  field.String // 提交语言
 	Code      field.String // 代码
+	Stdin     field.String // 标准输入
 	CreatedAt field.Time   // 提交时间
 
 	fieldMap map[string]field.Expr
@@ -75,6 +77,7 @@ func (s *submitInfo) updateTableName(table string) *submitInfo {
 	s.AppID = field.NewUint64(table, "app_id")
 	s.Language = field.NewString(table, "language")
 	s.Code = field.NewString(table, "code")
+	s.Stdin = field.NewString(table, "stdin")
 	s.CreatedAt = field.NewTime(table, "created_at")
 
 	s.fillFieldMap()
@@ -92,13 +95,14 @@ func (s *submitInfo) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *submitInfo) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 7)
+	s.fieldMap = make(map[string]field.Expr, 8)
 	s.fieldMap["id"] = s.ID
 	s.fieldMap["submit_id"] = s.SubmitID
 	s.fieldMap["task_id"] = s.TaskID
 	s.fieldMap["app_id"] = s.AppID
 	s.fieldMap["language"] = s.Language
 	s.fieldMap["code"] = s.Code
+	s.fieldMap["stdin"] = s.Stdin
 	s.fieldMap["created_at"] = s.CreatedAt
 }
 
@@ -35,6 +35,12 @@ func newTaskInfo(db *gorm.DB, opts ...gen.DOOption) taskInfo {
 	_taskInfo.ErrOutput = field.NewString(tableName, "err_output")
 	_taskInfo.Memory = field.NewInt64(tableName, "memory")
 	_taskInfo.Time = field.NewInt64(tableName, "time")
+	_taskInfo.CPUTime = field.NewInt64(tableName, "cpu_time")
+	_taskInfo.ExitCode = field.NewInt32(tableName, "exit_code")
+	_taskInfo.CompileOutput = field.NewString(tableName, "compile_output")
+	_taskInfo.CompileErrOutput = field.NewString(tableName, "compile_err_output")
+	_taskInfo.CompileTime = field.NewInt64(tableName, "compile_time")
+	_taskInfo.Score = field.NewInt32(tableName, "score")
 	_taskInfo.CreatedAt = field.NewTime(tableName, "created_at")
 	_taskInfo.UpdatedAt = field.NewTime(tableName, "updated_at")
 
@@ -47,16 +53,22 @@ func newTaskInfo(db *gorm.DB, opts ...gen.DOOption) taskInfo {
 type taskInfo struct {
 	taskInfoDo
 
-	ALL       field.Asterisk
-	ID        field.String // 任务ID
-	Language  field.String
-	Status    field.Field  // 任务状态 0 - 待执行 1 - 执行中 2 - 执行成功 3 - 执行失败
-	Output    field.String // 执行结果
-	ErrOutput field.String // 错误输出
-	Memory    field.Int64  // 内存使用
-	Time      field.Int64  // 执行时间
-	CreatedAt field.Time   // 创建时间
-	UpdatedAt field.Time   // 更新时间
+	ALL              field.Asterisk
+	ID               field.String // 任务ID
+	Language         field.String
+	Status           field.Field  // 任务状态 0 - 待执行 1 - 执行中 2 - 执行成功 3 - 执行失败
+	Output           field.String // 执行结果
+	ErrOutput        field.String // 错误输出
+	Memory           field.Int64  // 内存使用
+	Time             field.Int64  // 执行时间
+	CPUTime          field.Int64  // CPU时间
+	ExitCode         field.Int32  // 退出码
+	CompileOutput    field.String // 编译输出
+	CompileErrOutput field.String // 编译错误输出
+	CompileTime      field.Int64  // 编译时间
+	Score            field.Int32  // 得分
+	CreatedAt        field.Time   // 创建时间
+	UpdatedAt        field.Time   // 更新时间
 
 	fieldMap map[string]field.Expr
 }
@@ -80,6 +92,12 @@ func (t *taskInfo) updateTableName(table string) *taskInfo {
 	t.ErrOutput = field.NewString(table, "err_output")
 	t.Memory = field.NewInt64(table, "memory")
 	t.Time = field.NewInt64(table, "time")
+	t.CPUTime = field.NewInt64(table, "cpu_time")
+	t.ExitCode = field.NewInt32(table, "exit_code")
+	t.CompileOutput = field.NewString(table, "compile_output")
+	t.CompileErrOutput = field.NewString(table, "compile_err_output")
+	t.CompileTime = field.NewInt64(table, "compile_time")
+	t.Score = field.NewInt32(table, "score")
 	t.CreatedAt = field.NewTime(table, "created_at")
 	t.UpdatedAt = field.NewTime(table, "updated_at")
 
@@ -98,7 +116,7 @@ func (t *taskInfo) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (t *taskInfo) fillFieldMap() {
-	t.fieldMap = make(map[string]field.Expr, 9)
+	t.fieldMap = make(map[string]field.Expr, 15)
 	t.fieldMap["id"] = t.ID
 	t.fieldMap["language"] = t.Language
 	t.fieldMap["status"] = t.Status
@@ -106,6 +124,12 @@ func (t *taskInfo) fillFieldMap() {
 	t.fieldMap["err_output"] = t.ErrOutput
 	t.fieldMap["memory"] = t.Memory
 	t.fieldMap["time"] = t.Time
+	t.fieldMap["cpu_time"] = t.CPUTime
+	t.fieldMap["exit_code"] = t.ExitCode
+	t.fieldMap["compile_output"] = t.CompileOutput
+	t.fieldMap["compile_err_output"] = t.CompileErrOutput
+	t.fieldMap["compile_time"] = t.CompileTime
+	t.fieldMap["score"] = t.Score
 	t.fieldMap["created_at"] = t.CreatedAt
 	t.fieldMap["updated_at"] = t.UpdatedAt
 }
@@ -36,6 +36,7 @@ func (s *SubmitInfoRepository) CreateSubmitInfo(ctx context.Context, submitInfo
 		AppID:    submitInfo.AppID,
 		Language: submitInfo.Language.String(),
 		Code:     &submitInfo.Code,
+		Stdin:    &submitInfo.Stdin,
 	}); err != nil {
 		return err
 	}
@@ -54,13 +55,18 @@ func submitInfosConvert(infos []*model.SubmitInfo) []*aggregate.Task {
 	var results []*aggregate.Task
 	
 	for _, info := range infos {
-		results = append(results, &aggregate.Task{
+		task := &aggregate.Task{
 			ID:       info.TaskID,
 			SubmitID: info.SubmitID,
 			AppID:    info.AppID,
 			Language: vo.GetLanguageByType(info.Language),
 			Code:     *info.Code,
-		})
+		}
+		// 重新执行时需要使用与首次提交相同的输入
+		if info.Stdin != nil {
+			task.Stdin = *info.Stdin
+		}
+		results = append(results, task)
 	}
 	
 	return results
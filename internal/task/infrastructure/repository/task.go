@@ -37,23 +37,34 @@ func (t *TaskInfoRepository) UpdateTaskInfo(ctx context.Context, task *aggregate
 }
 
 func taskConvert(task *aggregate.Task) *model.TaskInfo {
-	if task.Memory == 0 || task.Time == 0 {
-		return &model.TaskInfo{
-			Status:    task.Status.GetCode(),
-			Language:  task.Language.GetType(),
-			Output:    task.Stdout,
-			ErrOutput: task.Stderr,
-		}
-	}
-	t := task.Time.Milliseconds()
-	return &model.TaskInfo{
-		Status:    task.Status.GetCode(),
-		Language:  task.Language.GetType(),
-		Output:    task.Stdout,
-		ErrOutput: task.Stderr,
-		Memory:    &task.Memory,
-		Time:      &t,
+	info := &model.TaskInfo{
+		Status:           task.Status.GetCode(),
+		Language:         task.Language.GetType(),
+		Output:           task.Stdout,
+		ErrOutput:        task.Stderr,
+		CompileOutput:    task.CompileStdout,
+		CompileErrOutput: task.CompileStderr,
 	}
+	if task.Status.GetCode() != vo.Failed.GetCode() {
+		exitCode := int32(task.ExitCode)
+		info.ExitCode = &exitCode
+	}
+	if task.Memory != 0 {
+		info.Memory = &task.Memory
+	}
+	if task.Time != 0 {
+		t := task.Time.Milliseconds()
+		info.Time = &t
+	}
+	if task.CPUTime != 0 {
+		t := task.CPUTime.Milliseconds()
+		info.CPUTime = &t
+	}
+	if task.CompileTime != 0 {
+		t := task.CompileTime.Milliseconds()
+		info.CompileTime = &t
+	}
+	return info
 }
 
 func (t *TaskInfoRepository) GetTaskResult(ctx context.Context, taskID string) (*aggregate.Task, error) {
@@ -71,24 +82,32 @@ func (t *TaskInfoRepository) GetTaskResult(ctx context.Context, taskID string) (
 			Status:   *vo.GetStatusByCode(taskInfo.Status),
 		}, nil
 	}
-	if taskInfo.Memory == nil || taskInfo.Time == nil {
-		return &aggregate.Task{
-			ID:       taskInfo.ID,
-			Language: vo.GetLanguageByType(taskInfo.Language),
-			Status:   *vo.GetStatusByCode(taskInfo.Status),
-			Stdout:   taskInfo.Output,
-			Stderr:   taskInfo.ErrOutput,
-		}, nil
-	}
-	return &aggregate.Task{
+	task := &aggregate.Task{
 		ID:       taskInfo.ID,
 		Language: vo.GetLanguageByType(taskInfo.Language),
 		Status:   *vo.GetStatusByCode(taskInfo.Status),
 		Stdout:   taskInfo.Output,
 		Stderr:   taskInfo.ErrOutput,
-		Time:     time.Duration(*taskInfo.Time) * time.Millisecond,
-		Memory:   *taskInfo.Memory,
-	}, nil
+		
+		CompileStdout: taskInfo.CompileOutput,
+		CompileStderr: taskInfo.CompileErrOutput,
+	}
+	if taskInfo.ExitCode != nil {
+		task.ExitCode = int(*taskInfo.ExitCode)
+	}
+	if taskInfo.Memory != nil {
+		task.Memory = *taskInfo.Memory
+	}
+	if taskInfo.Time != nil {
+		task.Time = time.Duration(*taskInfo.Time) * time.Millisecond
+	}
+	if taskInfo.CPUTime != nil {
+		task.CPUTime = time.Duration(*taskInfo.CPUTime) * time.Millisecond
+	}
+	if taskInfo.CompileTime != nil {
+		task.CompileTime = time.Duration(*taskInfo.CompileTime) * time.Millisecond
+	}
+	return task, nil
 }
 
 func NewTaskInfoRepository() repository.TaskInfoRepository {
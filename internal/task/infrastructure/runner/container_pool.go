@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
+	"path"
 	"sync"
 	"time"
 	
@@ -14,9 +16,13 @@ import (
 	"go.uber.org/zap"
 	
 	"github.com/Wenrh2004/sandbox/pkg/log"
+	"github.com/Wenrh2004/sandbox/pkg/monitor"
 	"github.com/Wenrh2004/sandbox/pkg/quene"
 )
 
+// 监控程序在宿主机上的默认路径
+const defaultMonitorPath = "bin/sandbox-monitor"
+
 // 容器状态
 const (
 	ContainerStatusCreating   = "creating"   // 容器创建中
@@ -34,6 +40,7 @@ type Container struct {
 	Language string
 	Status   string
 	LastUsed time.Time
+	Limits   ResourceLimits // 容器创建时应用的资源限制
 }
 
 // ContainerPool 管理容器的池子
@@ -42,9 +49,12 @@ type ContainerPool struct {
 	logger          *log.Logger
 	cli             *client.Client // Docker 客户端
 	mutex           sync.Mutex
-	maxPerLang      int           // 每种语言最大容器数
-	idleTimeout     time.Duration // 空闲容器超时时间
-	reservedPerLang int           // 每种语言预留的容器数
+	maxPerLang      int                       // 每种语言最大容器数
+	idleTimeout     time.Duration             // 空闲容器超时时间
+	reservedPerLang int                       // 每种语言预留的容器数
+	appLimits       ResourceLimits            // 全局资源限制
+	langLimits      map[string]ResourceLimits // 各语言最终生效的资源限制
+	monitor         []byte                    // 复制到每个容器中的监控程序
 }
 
 // NewContainerPool 创建一个新的容器池
@@ -66,10 +76,38 @@ func NewContainerPool(conf *viper.Viper, log *log.Logger, cli *client.Client) (*
 		reservedPerLang: reservedPerLang,
 	}
 	
+	// 读取监控程序，容器创建时会复制到容器中
+	monitorPath := conf.GetString("app.container.monitor")
+	if monitorPath == "" {
+		monitorPath = defaultMonitorPath
+	}
+	monitorBinary, err := os.ReadFile(monitorPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("[NewContainerPool]failed to read sandbox monitor %s: %v", monitorPath, err)
+	}
+	pool.monitor = monitorBinary
+	
+	// 加载资源限制
+	pool.appLimits, pool.langLimits, err = loadResourceLimits(conf, pool.getSupportedLanguages())
+	if err != nil {
+		return nil, nil, fmt.Errorf("[NewContainerPool]invalid resource limits: %v", err)
+	}
+	
 	pool.logger.Info("creating container pool",
 		zap.Int("maxPerLang", maxPerLang),
 		zap.Int("reservedPerLang", reservedPerLang),
 		zap.Duration("idleTimeout", idleTimeout))
+	for lang, limits := range pool.langLimits {
+		pool.logger.Info("container resource limits",
+			zap.String("language", lang),
+			zap.Int64("memory", limits.Memory),
+			zap.Int64("memorySwap", limits.MemorySwap),
+			zap.Int64("cpuPeriod", limits.CPUPeriod),
+			zap.Int64("cpuQuota", limits.CPUQuota),
+			zap.Int64("cpuShares", limits.CPUShares),
+			zap.Int64("pidsLimit", limits.PidsLimit),
+			zap.Any("ulimits", limits.Ulimits))
+	}
 	
 	// 启动清理协程
 	go pool.cleanupIdleContainers()
@@ -141,6 +179,14 @@ func (p *ContainerPool) getSupportedLanguages() []string {
 	return supportedLanguages
 }
 
+// GetResourceLimits 返回指定语言的容器生效的资源限制
+func (p *ContainerPool) GetResourceLimits(language string) ResourceLimits {
+	if limits, ok := p.langLimits[language]; ok {
+		return limits
+	}
+	return p.appLimits
+}
+
 // GetContainer 从池中获取一个容器，如果没有可用的则创建一个新的
 func (p *ContainerPool) GetContainer(ctx context.Context, language string) (*Container, error) {
 	p.logger.Debug("getting container for language", zap.String("language", language))
@@ -152,6 +198,7 @@ func (p *ContainerPool) GetContainer(ctx context.Context, language string) (*Con
 	}
 	
 	image := strategy.GetImage()
+	limits := p.GetResourceLimits(language)
 	
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
@@ -220,7 +267,9 @@ func (p *ContainerPool) GetContainer(ctx context.Context, language string) (*Con
 	// 创建新容器
 	p.logger.Info("creating new container",
 		zap.String("language", language),
-		zap.String("image", image))
+		zap.String("image", image),
+		zap.Int64("memory", limits.Memory),
+		zap.Int64("pidsLimit", limits.PidsLimit))
 	
 	containerConfig := &container.Config{
 		Image: image,
@@ -229,6 +278,7 @@ func (p *ContainerPool) GetContainer(ctx context.Context, language string) (*Con
 	}
 	hostConfig := &container.HostConfig{
 		AutoRemove: false,
+		Resources:  limits.toResources(),
 	}
 	
 	// 设置创建状态
@@ -237,6 +287,7 @@ func (p *ContainerPool) GetContainer(ctx context.Context, language string) (*Con
 		Language: language,
 		Status:   ContainerStatusCreating,
 		LastUsed: time.Now(),
+		Limits:   limits,
 	}
 	
 	// 检查镜像是否存在
@@ -292,6 +343,15 @@ func (p *ContainerPool) GetContainer(ctx context.Context, language string) (*Con
 	// 更新容器ID
 	newContainer.ID = containerResp.ID
 	
+	// 复制监控程序到容器中
+	if err := copyFileToContainer(ctx, p.cli, containerResp.ID, path.Dir(monitor.Path), path.Base(monitor.Path), p.monitor, 0755); err != nil {
+		p.logger.Error("failed to copy sandbox monitor",
+			zap.String("containerId", newContainer.ID),
+			zap.Error(err))
+		_ = p.cli.ContainerRemove(ctx, containerResp.ID, container.RemoveOptions{Force: true})
+		return nil, err
+	}
+	
 	// 启动容器
 	p.logger.Debug("starting container", zap.String("containerId", newContainer.ID))
 	if err := p.cli.ContainerStart(ctx, containerResp.ID, container.StartOptions{}); err != nil {
@@ -402,6 +462,49 @@ func (p *ContainerPool) ReleaseContainer(containerID string) {
 	}
 }
 
+// RemoveContainer 将容器移出池并强制删除，容器内残留的进程会随之被杀死
+func (p *ContainerPool) RemoveContainer(containerID string) {
+	p.mutex.Lock()
+	var removed *Container
+	for lang, queue := range p.containers {
+		var containersToKeep []*Container
+		queue.ForEach(func(c *Container) {
+			if c.ID == containerID {
+				removed = c
+				return
+			}
+			containersToKeep = append(containersToKeep, c)
+		})
+		if removed == nil {
+			continue
+		}
+		
+		removed.Status = ContainerStatusDestroying
+		newQueue := quene.NewRingQueue[*Container](p.maxPerLang)
+		for _, c := range containersToKeep {
+			_ = newQueue.Enqueue(c)
+		}
+		p.containers[lang] = newQueue
+		break
+	}
+	p.mutex.Unlock()
+	
+	if removed == nil {
+		p.logger.Warn("container not found during remove",
+			zap.String("containerId", containerID))
+		return
+	}
+	
+	p.logger.Info("removing container",
+		zap.String("containerId", containerID),
+		zap.String("language", removed.Language))
+	if err := p.cli.ContainerRemove(context.Background(), containerID, container.RemoveOptions{Force: true}); err != nil {
+		p.logger.Warn("error removing container",
+			zap.String("containerId", containerID),
+			zap.Error(err))
+	}
+}
+
 // cleanupIdleContainers 定期清理空闲超时的容器
 func (p *ContainerPool) cleanupIdleContainers() {
 	ticker := time.NewTicker(10 * p.idleTimeout)
@@ -1,6 +1,17 @@
 package runner
 
-import "github.com/docker/docker/client"
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+)
 
 func NewClient() *client.Client {
 	cli, err := client.NewClientWithOpts(client.FromEnv)
@@ -9,3 +20,54 @@ func NewClient() *client.Client {
 	}
 	return cli
 }
+
+// copyFileToContainer 将单个文件打包为 tar 后复制到容器的指定目录
+func copyFileToContainer(ctx context.Context, cli *client.Client, containerID, dir, name string, content []byte, mode int64) error {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    mode,
+		Size:    int64(len(content)),
+		ModTime: time.Now(),
+	}); err != nil {
+		return err
+	}
+	if _, err := tw.Write(content); err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return cli.CopyToContainer(ctx, containerID, dir, &buf, container.CopyToContainerOptions{})
+}
+
+// execInContainer 在容器中执行命令并等待其结束，退出码非 0 时返回包含输出的错误
+func execInContainer(ctx context.Context, cli *client.Client, containerID string, cmd []string) error {
+	execResp, err := cli.ContainerExecCreate(ctx, containerID, container.ExecOptions{
+		AttachStdout: true,
+		AttachStderr: true,
+		Cmd:          cmd,
+	})
+	if err != nil {
+		return err
+	}
+	resp, err := cli.ContainerExecAttach(ctx, execResp.ID, container.ExecAttachOptions{})
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+	
+	var output strings.Builder
+	if _, err := stdcopy.StdCopy(&output, &output, resp.Reader); err != nil {
+		return err
+	}
+	inspect, err := cli.ContainerExecInspect(ctx, execResp.ID)
+	if err != nil {
+		return err
+	}
+	if inspect.ExitCode != 0 {
+		return fmt.Errorf("%s exited with code %d: %s", cmd[0], inspect.ExitCode, strings.TrimSpace(output.String()))
+	}
+	return nil
+}
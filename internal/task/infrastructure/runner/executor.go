@@ -2,17 +2,75 @@ package runner
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"path/filepath"
 	"strings"
+	"time"
 	
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/client"
 	"github.com/docker/docker/pkg/stdcopy"
+	
+	"github.com/Wenrh2004/sandbox/pkg/monitor"
 )
 
+// errOutputLimitExceeded 用户程序的输出超过了限制
+var errOutputLimitExceeded = errors.New("output limit exceeded")
+
+// 为监控程序的运行报告预留的输出空间
+const reportReserve = 1024
+
+// PhaseLimits 单个执行阶段的资源限制
+type PhaseLimits struct {
+	TimeLimit    time.Duration // 墙钟时间限制
+	CPUTimeLimit time.Duration // CPU 时间限制
+	MemoryLimit  int64         // 内存限制（字节），为 0 时只受容器的内存上限约束
+}
+
+// ExecRequest 代码执行请求
+type ExecRequest struct {
+	Language      string
+	FileName      string
+	FileContent   string
+	Stdin         string      // 运行阶段的标准输入
+	CompileLimits PhaseLimits // 编译阶段的限制，只对需要编译的语言生效
+	RunLimits     PhaseLimits // 运行阶段的限制
+	OutputLimit   int64       // 每个阶段标准输出与标准错误的总字节数限制
+	
+	// Runs 不为空时编译一次后在同一个容器中依次运行每组输入，此时忽略 Stdin 与 RunLimits
+	Runs []RunInput
+}
+
+// RunInput 一次运行的输入及限制
+type RunInput struct {
+	Stdin  string
+	Limits PhaseLimits
+}
+
+// PhaseResult 单个执行阶段的结果，资源统计只包含该阶段启动的进程
+type PhaseResult struct {
+	Verdict  Verdict
+	Stdout   string
+	Stderr   string
+	ExitCode int
+	Signal   int           // 终止进程的信号，正常退出时为 0
+	Time     time.Duration // 墙钟时间
+	CPUTime  time.Duration // CPU 时间
+	Memory   int64         // 峰值内存（字节）
+}
+
+// ExecResult 代码执行结果，内嵌的 PhaseResult 为运行阶段的结果
+type ExecResult struct {
+	PhaseResult
+	Compile *PhaseResult   // 编译阶段的结果，无需编译的语言为 nil
+	Runs    []*PhaseResult // 与 ExecRequest.Runs 一一对应的运行结果
+}
+
 type CodeRunner interface {
-	Exec(ctx context.Context, language string, filename string, fileContent string) (output string, err error)
+	Exec(ctx context.Context, req *ExecRequest) (*ExecResult, error)
+	GetResourceLimits(language string) ResourceLimits
 }
 
 type codeRunner struct {
@@ -27,29 +85,37 @@ func NewCodeRunner(pool *ContainerPool, cli *client.Client) CodeRunner {
 	}
 }
 
-func (cr *codeRunner) Exec(ctx context.Context, language, filePath, fileContent string) (string, error) {
-	strategy := GetStrategy(language)
+// GetResourceLimits 返回执行指定语言代码时生效的资源限制
+func (cr *codeRunner) GetResourceLimits(language string) ResourceLimits {
+	return cr.pool.GetResourceLimits(language)
+}
+
+func (cr *codeRunner) Exec(ctx context.Context, req *ExecRequest) (*ExecResult, error) {
+	strategy := GetStrategy(req.Language)
 	if strategy == nil {
-		return "", fmt.Errorf("unsupported language: %s", language)
+		return nil, fmt.Errorf("unsupported language: %s", req.Language)
 	}
 	
-	cmd := strategy.GetExecCommand(filepath.Base(filePath))
-	
 	// 从池中获取一个容器（此时容器状态为pending）
-	c, err := cr.pool.GetContainer(ctx, language)
+	c, err := cr.pool.GetContainer(ctx, req.Language)
 	if err != nil {
-		return "", fmt.Errorf("failed to get container from pool: %v", err)
+		return nil, fmt.Errorf("failed to get container from pool: %v", err)
 	}
 	
-	// 使用完后将容器状态设置为releasing并最终归还到池中
+	// 使用完后将容器状态设置为releasing并最终归还到池中，超时的容器直接回收
+	recycle := false
 	defer func() {
+		if recycle {
+			cr.pool.RemoveContainer(c.ID)
+			return
+		}
 		cr.pool.ReleaseContainer(c.ID)
 	}()
 	
 	// 在容器中创建文件并写入内容
-	fileName := filepath.Base(filePath)
+	fileName := filepath.Base(req.FileName)
 	createFileCmd := fmt.Sprintf("mkdir -p /app && echo '%s' > /app/%s",
-		strings.ReplaceAll(fileContent, "'", "'\"'\"'"), fileName)
+		strings.ReplaceAll(req.FileContent, "'", "'\"'\"'"), fileName)
 	
 	execResp, err := cr.cli.ContainerExecCreate(ctx, c.ID, container.ExecOptions{
 		AttachStdout: true,
@@ -57,46 +123,191 @@ func (cr *codeRunner) Exec(ctx context.Context, language, filePath, fileContent
 		Cmd:          []string{"sh", "-c", createFileCmd},
 	})
 	if err != nil {
-		return "", fmt.Errorf("failed to create file in container: %v", err)
+		return nil, fmt.Errorf("failed to create file in container: %v", err)
 	}
 	
 	if err = cr.cli.ContainerExecStart(ctx, execResp.ID, container.ExecStartOptions{}); err != nil {
-		return "", fmt.Errorf("failed to start exec command: %v", err)
+		return nil, fmt.Errorf("failed to start exec command: %v", err)
 	}
 	
 	// 设置容器状态为running
 	cr.pool.SetContainerRunning(c.ID)
 	
-	// 在容器中执行命令
+	// 编译阶段失败时不再运行，编译命令可能由多条命令组成，交给 shell 执行
+	result := &ExecResult{}
+	if compiler, ok := strategy.(Compiler); ok {
+		var compile *PhaseResult
+		cmd := "sh -c " + shellQuote(compiler.GetCompileCommand(fileName))
+		compile, recycle, err = cr.runPhase(ctx, c, cmd, nil, req.CompileLimits, req.OutputLimit)
+		if err != nil {
+			return nil, fmt.Errorf("compile: %v", err)
+		}
+		result.Compile = compile
+		if compile.Verdict != VerdictOK {
+			result.Verdict = VerdictCompileError
+			result.ExitCode = compile.ExitCode
+			return result, nil
+		}
+	}
+	
+	cmd := strategy.GetRunCommand(fileName)
+	if len(req.Runs) == 0 {
+		run, needRecycle, err := cr.runPhase(ctx, c, cmd, strings.NewReader(req.Stdin), req.RunLimits, req.OutputLimit)
+		recycle = needRecycle
+		if err != nil {
+			return nil, err
+		}
+		result.PhaseResult = *run
+		return result, nil
+	}
+	
+	for _, input := range req.Runs {
+		run, needRecycle, err := cr.runPhase(ctx, c, cmd, strings.NewReader(input.Stdin), input.Limits, req.OutputLimit)
+		if needRecycle {
+			recycle = true
+		}
+		if err != nil {
+			return nil, err
+		}
+		// 超时等情况下上一次运行的进程可能仍在执行，结束它们以免影响后续运行，容器最终仍会回收
+		if needRecycle {
+			if err := execInContainer(ctx, cr.cli, c.ID, []string{"sh", "-c", "kill -9 -1 || true"}); err != nil {
+				return nil, fmt.Errorf("failed to kill remaining processes: %v", err)
+			}
+		}
+		result.Runs = append(result.Runs, run)
+	}
+	
+	return result, nil
+}
+
+// runPhase 在容器中通过监控程序执行一个阶段的命令，stdin 不为 nil 时写入进程的标准输入。
+// 返回的 recycle 表示执行后容器需要回收
+func (cr *codeRunner) runPhase(ctx context.Context, c *Container, cmd string, stdin io.Reader, limits PhaseLimits, outputLimit int64) (*PhaseResult, bool, error) {
+	// CPU 时间与内存限制由监控程序设置
 	execConfig := container.ExecOptions{
+		AttachStdin:  stdin != nil,
 		AttachStdout: true,
 		AttachStderr: true,
-		Cmd:          []string{"sh", "-c", fmt.Sprintf("cd /app && %s", cmd)},
+		Cmd:          []string{"sh", "-c", fmt.Sprintf("cd /app && exec %s %s", monitor.Path, cmd)},
+	}
+	if limits.CPUTimeLimit > 0 {
+		execConfig.Env = append(execConfig.Env, fmt.Sprintf("%s=%d", monitor.EnvCPULimit, limits.CPUTimeLimit.Milliseconds()))
+	}
+	if limits.MemoryLimit > 0 {
+		execConfig.Env = append(execConfig.Env, fmt.Sprintf("%s=%d", monitor.EnvMemoryLimit, limits.MemoryLimit))
 	}
 	
-	execResp, err = cr.cli.ContainerExecCreate(ctx, c.ID, execConfig)
+	execResp, err := cr.cli.ContainerExecCreate(ctx, c.ID, execConfig)
 	if err != nil {
-		return "", err
+		return nil, false, err
 	}
 	
 	resp, err := cr.cli.ContainerExecAttach(ctx, execResp.ID, container.ExecAttachOptions{})
 	if err != nil {
-		return "", err
+		return nil, false, err
 	}
 	defer resp.Close()
 	
+	// 输入写完后关闭写端，用户程序读到 EOF；程序不读取输入时写入失败可以忽略
+	if stdin != nil {
+		go func() {
+			_, _ = io.Copy(resp.Conn, stdin)
+			_ = resp.CloseWrite()
+		}()
+	}
+	
 	var outBuf, errBuf strings.Builder
-	_, err = stdcopy.StdCopy(&outBuf, &errBuf, resp.Reader)
-	if err != nil {
-		return "", err
+	var stdout, stderr io.Writer = &outBuf, &errBuf
+	if outputLimit > 0 {
+		remaining := outputLimit + reportReserve
+		stdout = &limitedWriter{w: &outBuf, remaining: &remaining}
+		stderr = &limitedWriter{w: &errBuf, remaining: &remaining}
 	}
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		_, err := stdcopy.StdCopy(stdout, stderr, resp.Reader)
+		done <- err
+	}()
 	
-	output := outBuf.String()
-	errOutput := errBuf.String()
+	// 墙钟时间或输出超限时关闭连接，并回收容器以杀死整个进程树
+	var timeout <-chan time.Time
+	if limits.TimeLimit > 0 {
+		timer := time.NewTimer(limits.TimeLimit)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case err = <-done:
+		if errors.Is(err, errOutputLimitExceeded) {
+			return &PhaseResult{
+				Verdict: VerdictOutputLimitExceeded,
+				Stdout:  outBuf.String(),
+				Stderr:  errBuf.String(),
+				Time:    time.Since(start),
+			}, true, nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
+	case <-timeout:
+		resp.Close()
+		<-done
+		return &PhaseResult{
+			Verdict: VerdictTimeLimitExceeded,
+			Stdout:  outBuf.String(),
+			Stderr:  errBuf.String(),
+			Time:    time.Since(start),
+		}, true, nil
+	case <-ctx.Done():
+		resp.Close()
+		<-done
+		return nil, true, ctx.Err()
+	}
 	
-	if errOutput != "" {
-		return output + "\n" + errOutput, nil
+	stderrOutput, report, err := monitor.Extract(errBuf.String())
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read monitor report: %v", err)
+	}
+	result := &PhaseResult{
+		Stdout:   outBuf.String(),
+		Stderr:   stderrOutput,
+		ExitCode: report.ExitCode,
+		Signal:   report.Signal,
+		Time:     report.GetTime(),
+		CPUTime:  report.GetCPUTime(),
+		Memory:   report.Memory,
+	}
+	result.Verdict = judgeReport(report, limits, c.Limits)
+	if outputLimit > 0 && int64(len(result.Stdout)+len(result.Stderr)) > outputLimit {
+		result.Verdict = VerdictOutputLimitExceeded
 	}
 	
-	return output, nil
+	// 超时或内存超限后容器内可能残留异常进程，直接回收
+	recycle := result.Verdict == VerdictTimeLimitExceeded || result.Verdict == VerdictMemoryLimitExceeded
+	
+	return result, recycle, nil
+}
+
+// shellQuote 将字符串转义为单个 shell 参数
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "'\"'\"'") + "'"
+}
+
+// limitedWriter 限制写入的总字节数，多个 limitedWriter 可以共享同一个额度
+type limitedWriter struct {
+	w         io.Writer
+	remaining *int64
+}
+
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	if int64(len(p)) > *l.remaining {
+		n, _ := l.w.Write(p[:*l.remaining])
+		*l.remaining = 0
+		return n, errOutputLimitExceeded
+	}
+	n, err := l.w.Write(p)
+	*l.remaining -= int64(n)
+	return n, err
 }
@@ -0,0 +1,206 @@
+package runner
+
+import (
+	"fmt"
+	"sort"
+	
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-units"
+	"github.com/spf13/viper"
+)
+
+// 默认资源限制，未配置时同样生效，避免用户代码拖垮宿主机
+var defaultResourceLimits = ResourceLimits{
+	Memory:     256 * units.MiB,
+	MemorySwap: 256 * units.MiB,
+	CPUPeriod:  100000,
+	CPUQuota:   100000,
+	CPUShares:  1024,
+	PidsLimit:  64,
+	Ulimits: map[string]int64{
+		"nofile": 256,
+		"fsize":  64 * units.MiB,
+	},
+}
+
+// ResourceLimits 容器资源限制
+type ResourceLimits struct {
+	Memory     int64            // 内存上限（字节）
+	MemorySwap int64            // 内存 + swap 上限（字节），与 Memory 相等时禁用 swap
+	CPUPeriod  int64            // CFS 调度周期（微秒）
+	CPUQuota   int64            // 每个调度周期内可用的 CPU 时间（微秒）
+	CPUShares  int64            // CPU 相对权重
+	PidsLimit  int64            // 进程数上限
+	Ulimits    map[string]int64 // ulimit，软限制与硬限制相同
+}
+
+// resourceLimitsConfig 资源限制的配置格式，内存类的值支持 256m、1g 等写法
+type resourceLimitsConfig struct {
+	Memory     string            `mapstructure:"memory"`
+	MemorySwap string            `mapstructure:"memory_swap"`
+	CPUPeriod  int64             `mapstructure:"cpu_period"`
+	CPUQuota   int64             `mapstructure:"cpu_quota"`
+	CPUShares  int64             `mapstructure:"cpu_shares"`
+	PidsLimit  int64             `mapstructure:"pids_limit"`
+	Ulimits    map[string]string `mapstructure:"ulimits"`
+}
+
+// toResourceLimits 将配置解析为资源限制，未配置的项保持零值
+func (c *resourceLimitsConfig) toResourceLimits() (ResourceLimits, error) {
+	var (
+		limits ResourceLimits
+		err    error
+	)
+	if c.Memory != "" {
+		if limits.Memory, err = units.RAMInBytes(c.Memory); err != nil {
+			return limits, fmt.Errorf("invalid memory %q: %v", c.Memory, err)
+		}
+	}
+	if c.MemorySwap != "" {
+		if c.MemorySwap == "-1" {
+			limits.MemorySwap = -1
+		} else if limits.MemorySwap, err = units.RAMInBytes(c.MemorySwap); err != nil {
+			return limits, fmt.Errorf("invalid memory_swap %q: %v", c.MemorySwap, err)
+		}
+	}
+	limits.CPUPeriod = c.CPUPeriod
+	limits.CPUQuota = c.CPUQuota
+	limits.CPUShares = c.CPUShares
+	limits.PidsLimit = c.PidsLimit
+	if len(c.Ulimits) > 0 {
+		limits.Ulimits = make(map[string]int64, len(c.Ulimits))
+		for name, value := range c.Ulimits {
+			v, err := units.RAMInBytes(value)
+			if err != nil {
+				return limits, fmt.Errorf("invalid ulimit %s %q: %v", name, value, err)
+			}
+			limits.Ulimits[name] = v
+		}
+	}
+	return limits, nil
+}
+
+// Merge 使用 override 中已配置的项覆盖当前限制，返回新的限制
+func (l ResourceLimits) Merge(override ResourceLimits) ResourceLimits {
+	merged := l
+	if override.Memory != 0 {
+		merged.Memory = override.Memory
+		// 只覆盖了内存时保持原有的 swap 策略
+		if override.MemorySwap == 0 && l.MemorySwap != -1 {
+			merged.MemorySwap = override.Memory + l.MemorySwap - l.Memory
+		}
+	}
+	if override.MemorySwap != 0 {
+		merged.MemorySwap = override.MemorySwap
+	}
+	if override.CPUPeriod != 0 {
+		merged.CPUPeriod = override.CPUPeriod
+	}
+	if override.CPUQuota != 0 {
+		merged.CPUQuota = override.CPUQuota
+	}
+	if override.CPUShares != 0 {
+		merged.CPUShares = override.CPUShares
+	}
+	if override.PidsLimit != 0 {
+		merged.PidsLimit = override.PidsLimit
+	}
+	merged.Ulimits = make(map[string]int64, len(l.Ulimits)+len(override.Ulimits))
+	for name, value := range l.Ulimits {
+		merged.Ulimits[name] = value
+	}
+	for name, value := range override.Ulimits {
+		merged.Ulimits[name] = value
+	}
+	return merged
+}
+
+// Validate 校验资源限制是否合法
+func (l ResourceLimits) Validate() error {
+	if l.Memory <= 0 {
+		return fmt.Errorf("memory must be positive, got %d", l.Memory)
+	}
+	if l.MemorySwap != -1 && l.MemorySwap < l.Memory {
+		return fmt.Errorf("memory_swap (%d) must be -1 or not less than memory (%d)", l.MemorySwap, l.Memory)
+	}
+	if l.CPUQuota > 0 && l.CPUPeriod <= 0 {
+		return fmt.Errorf("cpu_period must be set when cpu_quota is set")
+	}
+	if l.PidsLimit <= 0 {
+		return fmt.Errorf("pids_limit must be positive, got %d", l.PidsLimit)
+	}
+	for name, value := range l.Ulimits {
+		if value < 0 {
+			return fmt.Errorf("ulimit %s must not be negative, got %d", name, value)
+		}
+	}
+	return nil
+}
+
+// toResources 转换为 Docker 的 cgroup 资源配置
+func (l ResourceLimits) toResources() container.Resources {
+	pidsLimit := l.PidsLimit
+	names := make([]string, 0, len(l.Ulimits))
+	for name := range l.Ulimits {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	ulimits := make([]*container.Ulimit, 0, len(names))
+	for _, name := range names {
+		ulimits = append(ulimits, &container.Ulimit{
+			Name: name,
+			Soft: l.Ulimits[name],
+			Hard: l.Ulimits[name],
+		})
+	}
+	return container.Resources{
+		Memory:     l.Memory,
+		MemorySwap: l.MemorySwap,
+		CPUPeriod:  l.CPUPeriod,
+		CPUQuota:   l.CPUQuota,
+		CPUShares:  l.CPUShares,
+		PidsLimit:  &pidsLimit,
+		Ulimits:    ulimits,
+	}
+}
+
+// loadResourceLimits 读取全局资源限制及各语言的覆盖配置，返回每种语言最终生效的限制
+func loadResourceLimits(conf *viper.Viper, languages []string) (ResourceLimits, map[string]ResourceLimits, error) {
+	appLimits := defaultResourceLimits
+	if conf.IsSet("app.container.limits") {
+		var c resourceLimitsConfig
+		if err := conf.UnmarshalKey("app.container.limits", &c); err != nil {
+			return appLimits, nil, fmt.Errorf("app.container.limits: %v", err)
+		}
+		override, err := c.toResourceLimits()
+		if err != nil {
+			return appLimits, nil, fmt.Errorf("app.container.limits: %v", err)
+		}
+		appLimits = appLimits.Merge(override)
+	}
+	if err := appLimits.Validate(); err != nil {
+		return appLimits, nil, fmt.Errorf("app.container.limits: %v", err)
+	}
+	
+	langLimits := make(map[string]ResourceLimits, len(languages))
+	for _, lang := range languages {
+		key := "app.container.languages." + lang + ".limits"
+		limits := appLimits
+		if conf.IsSet(key) {
+			var c resourceLimitsConfig
+			if err := conf.UnmarshalKey(key, &c); err != nil {
+				return appLimits, nil, fmt.Errorf("%s: %v", key, err)
+			}
+			override, err := c.toResourceLimits()
+			if err != nil {
+				return appLimits, nil, fmt.Errorf("%s: %v", key, err)
+			}
+			limits = appLimits.Merge(override)
+		}
+		if err := limits.Validate(); err != nil {
+			return appLimits, nil, fmt.Errorf("%s: %v", key, err)
+		}
+		langLimits[lang] = limits
+	}
+	return appLimits, langLimits, nil
+}
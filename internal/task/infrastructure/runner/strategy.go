@@ -1,8 +1,14 @@
 package runner
 
+// CodeExecutor 语言的执行策略，运行命令由监控程序启动并统计资源使用
 type CodeExecutor interface {
 	GetImage() string
-	GetExecCommand(filename string) string
+	GetRunCommand(filename string) string
+}
+
+// Compiler 需要编译的语言额外实现该接口，编译作为独立阶段执行，失败时不再运行
+type Compiler interface {
+	GetCompileCommand(filename string) string
 }
 
 var languageStrategyMap = map[string]CodeExecutor{}
@@ -25,7 +31,7 @@ func (p PythonExecutor) GetImage() string {
 	return "python:3.11.12"
 }
 
-func (p PythonExecutor) GetExecCommand(filename string) string {
+func (p PythonExecutor) GetRunCommand(filename string) string {
 	return "python " + filename
 }
 
@@ -40,8 +46,12 @@ func (c CppExecutor) GetImage() string {
 	return "gcc:12.4.0"
 }
 
-func (c CppExecutor) GetExecCommand(filename string) string {
-	return "sh -c 'g++ " + filename + " -o a.out && a.out'"
+func (c CppExecutor) GetCompileCommand(filename string) string {
+	return "g++ " + filename + " -o a.out"
+}
+
+func (c CppExecutor) GetRunCommand(filename string) string {
+	return "./a.out"
 }
 
 type GoExecutor struct{}
@@ -50,6 +60,10 @@ func (g GoExecutor) GetImage() string {
 	return "golang:1.23.8"
 }
 
-func (g GoExecutor) GetExecCommand(filename string) string {
-	return "go mod init awesome && go mod tidy && go run " + filename
+func (g GoExecutor) GetCompileCommand(filename string) string {
+	return "go mod init awesome && go mod tidy && go build -o main " + filename
+}
+
+func (g GoExecutor) GetRunCommand(filename string) string {
+	return "./main"
 }
@@ -0,0 +1,36 @@
+package runner
+
+import (
+	"github.com/Wenrh2004/sandbox/pkg/monitor"
+)
+
+// Verdict 用户程序的执行结论
+type Verdict string
+
+const (
+	VerdictOK                  Verdict = "OK"
+	VerdictCompileError        Verdict = "CompileError"
+	VerdictRuntimeError        Verdict = "RuntimeError"
+	VerdictTimeLimitExceeded   Verdict = "TimeLimitExceeded"
+	VerdictMemoryLimitExceeded Verdict = "MemoryLimitExceeded"
+	VerdictOutputLimitExceeded Verdict = "OutputLimitExceeded"
+)
+
+// 峰值内存达到限制的该比例且进程被 SIGKILL 终止时，视为内存超限
+const oomMemoryRatio = 0.9
+
+// judgeReport 根据监控程序的运行报告得出单个阶段的执行结论
+func judgeReport(report *monitor.Report, phase PhaseLimits, limits ResourceLimits) Verdict {
+	if phase.CPUTimeLimit > 0 && (report.Signal == monitor.SignalXCPU ||
+		report.Signal == monitor.SignalKill && report.GetCPUTime() >= phase.CPUTimeLimit) {
+		return VerdictTimeLimitExceeded
+	}
+	if report.OOMKilled || report.MemoryExceeded ||
+		report.Signal == monitor.SignalKill && float64(report.Memory) >= float64(limits.Memory)*oomMemoryRatio {
+		return VerdictMemoryLimitExceeded
+	}
+	if report.Signal != 0 || report.ExitCode != 0 {
+		return VerdictRuntimeError
+	}
+	return VerdictOK
+}
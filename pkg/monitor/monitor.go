@@ -0,0 +1,73 @@
+package monitor
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	// Path 监控程序在沙箱容器中的路径
+	Path = "/usr/local/bin/sandbox-monitor"
+	// ReportMarker 监控程序在标准错误末尾输出运行报告时使用的前缀
+	ReportMarker = "__SANDBOX_MONITOR_REPORT__"
+	// EnvCPULimit 传递 CPU 时间限制（毫秒）的环境变量
+	EnvCPULimit = "SANDBOX_CPU_LIMIT"
+	// EnvMemoryLimit 传递内存限制（字节）的环境变量，超过后整个进程组会被终止
+	EnvMemoryLimit = "SANDBOX_MEMORY_LIMIT"
+)
+
+// 容器内的信号值，与宿主机平台无关
+const (
+	SignalKill = 9
+	SignalXCPU = 24
+)
+
+var ErrReportNotFound = errors.New("monitor report not found")
+
+// Report 用户程序的运行报告
+type Report struct {
+	ExitCode int   `json:"exit_code"`
+	Signal   int   `json:"signal"`
+	Time     int64 `json:"time"`     // 墙钟时间（纳秒）
+	CPUTime  int64 `json:"cpu_time"` // 用户态 + 内核态 CPU 时间（纳秒）
+	Memory   int64 `json:"memory"`   // 峰值常驻内存（字节）
+	
+	OOMKilled      bool `json:"oom_killed"`      // 运行期间容器是否发生过 OOM Kill
+	MemoryExceeded bool `json:"memory_exceeded"` // 是否因超过内存限制被监控程序终止
+}
+
+// GetTime 返回墙钟时间
+func (r *Report) GetTime() time.Duration {
+	return time.Duration(r.Time)
+}
+
+// GetCPUTime 返回 CPU 时间
+func (r *Report) GetCPUTime() time.Duration {
+	return time.Duration(r.CPUTime)
+}
+
+// Encode 生成追加在标准错误末尾的报告行
+func (r *Report) Encode() (string, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	return "\n" + ReportMarker + string(data) + "\n", nil
+}
+
+// Extract 从标准错误中分离出运行报告，返回去除报告后的标准错误。
+// 报告总是最后写出，因此以最后一次出现的标记为准，用户程序伪造的标记会保留在输出中
+func Extract(stderr string) (string, *Report, error) {
+	idx := strings.LastIndex(stderr, "\n"+ReportMarker)
+	if idx < 0 {
+		return stderr, nil, ErrReportNotFound
+	}
+	line := strings.TrimSpace(stderr[idx+len(ReportMarker)+1:])
+	var report Report
+	if err := json.Unmarshal([]byte(line), &report); err != nil {
+		return stderr, nil, err
+	}
+	return stderr[:idx], &report, nil
+}